@@ -79,6 +79,25 @@ Requires a first argument of the file to write to.
 Optional second and third arguments control the first and
 last epoch to write. If the file ends with .gz, the output will
 be gzipped
+`,
+			},
+			{
+				Name:      "dag-stats",
+				Usage:     "Export DAG statistics into a CSV file",
+				ArgsUsage: "<filename> [<epochFrom> <epochTo>]",
+				Action:    utils.MigrateFlags(exportDAGStats),
+				Flags: []cli.Flag{
+					DataDirFlag,
+				},
+				Description: `
+    opera export dag-stats
+
+Requires a first argument of the file to write to.
+Optional second and third arguments control the first and
+last epoch to analyze. The output contains a row per epoch
+with the number of events, validators, frames and roots,
+in/out-degree distributions of events and the number of forks.
+Rounds-to-decide isn't reported.
 `,
 			},
 			{
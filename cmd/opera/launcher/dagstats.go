@@ -0,0 +1,104 @@
+package launcher
+
+import (
+	"encoding/csv"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/Fantom-foundation/go-opera/gossip"
+	"github.com/Fantom-foundation/go-opera/integration"
+	"github.com/Fantom-foundation/go-opera/inter"
+	"github.com/Fantom-foundation/go-opera/utils/dagstats"
+)
+
+func exportDAGStats(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+
+	cfg := makeAllConfigs(ctx)
+
+	rawProducer := integration.DBProducer(path.Join(cfg.Node.DataDir, "chaindata"), cfg.cachescale)
+	gdb, err := makeRawGossipStore(rawProducer, cfg)
+	if err != nil {
+		log.Crit("DB opening error", "datadir", cfg.Node.DataDir, "err", err)
+	}
+	defer gdb.Close()
+
+	fn := ctx.Args().First()
+	fh, err := os.OpenFile(fn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	from := idx.Epoch(1)
+	if len(ctx.Args()) > 1 {
+		n, err := strconv.ParseUint(ctx.Args().Get(1), 10, 32)
+		if err != nil {
+			return err
+		}
+		from = idx.Epoch(n)
+	}
+	to := idx.Epoch(0)
+	if len(ctx.Args()) > 2 {
+		n, err := strconv.ParseUint(ctx.Args().Get(2), 10, 32)
+		if err != nil {
+			return err
+		}
+		to = idx.Epoch(n)
+	}
+
+	log.Info("Exporting DAG stats to file", "file", fn)
+	w := csv.NewWriter(fh)
+	err = exportDAGStatsTo(w, gdb, from, to)
+	if err != nil {
+		utils.Fatalf("Export error: %v\n", err)
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func exportDAGStatsTo(w *csv.Writer, gdb *gossip.Store, from, to idx.Epoch) (err error) {
+	start, reported := time.Now(), time.Time{}
+
+	err = w.Write(dagstats.Header)
+	if err != nil {
+		return err
+	}
+	var stats *dagstats.Epoch
+	gdb.ForEachEvent(from, func(e *inter.EventPayload) bool {
+		if to >= from && e.Epoch() > to {
+			return false
+		}
+		if stats == nil || stats.Epoch() != e.Epoch() {
+			if stats != nil {
+				err = w.Write(stats.Record())
+				if err != nil {
+					return false
+				}
+			}
+			stats = dagstats.NewEpoch(e.Epoch())
+		}
+		stats.Add(e)
+		if time.Since(reported) >= statsReportLimit {
+			log.Info("Exporting DAG stats", "epoch", e.Epoch(), "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+		return true
+	})
+	if err == nil && stats != nil {
+		err = w.Write(stats.Record())
+	}
+	log.Info("Exported DAG stats", "elapsed", common.PrettyDuration(time.Since(start)))
+
+	return
+}
@@ -363,6 +363,7 @@ func makeNode(ctx *cli.Context, cfg *config, genesisStore *genesisstore.Store) (
 	}
 
 	stack.RegisterAPIs(svc.APIs())
+	stack.RegisterAPIs(debug.APIs())
 	stack.RegisterProtocols(svc.Protocols())
 	stack.RegisterLifecycle(svc)
 
@@ -47,6 +47,10 @@ var (
 		Name:  "prune.genesis",
 		Usage: `prune genesis state (true by default)`,
 	}
+	PruneDryRunCommand = cli.BoolFlag{
+		Name:  "prune.dryrun",
+		Usage: `only report the number and size of state entries to be pruned, without deleting them (false by default)`,
+	}
 	snapshotCommand = cli.Command{
 		Name:        "snapshot",
 		Usage:       "A set of commands based on the snapshot",
@@ -56,12 +60,13 @@ var (
 			{
 				Name:      "prune-state",
 				Usage:     "Prune stale EVM state data based on the snapshot",
-				ArgsUsage: "<root> [--prune.exact] [--prune.genesis=false]",
+				ArgsUsage: "<root> [--prune.exact] [--prune.genesis=false] [--prune.dryrun]",
 				Action:    utils.MigrateFlags(pruneState),
 				Category:  "MISCELLANEOUS COMMANDS",
 				Flags: []cli.Flag{
 					PruneExactCommand,
 					PruneGenesisCommand,
+					PruneDryRunCommand,
 					DataDirFlag,
 					utils.AncientFlag,
 					utils.RopstenFlag,
@@ -83,6 +88,9 @@ WARNING: It's necessary to delete the trie clean cache after the pruning.
 If you specify another directory for the trie clean cache via "--cache.trie.journal"
 during the use of Geth, please also specify it here for correct deletion. Otherwise
 the trie clean cache with default directory will be deleted.
+
+Use --prune.dryrun to only report the number and size of trie nodes and
+contract codes which would be deleted, without modifying the database.
 `,
 			},
 			{
@@ -215,6 +223,13 @@ func pruneState(ctx *cli.Context) error {
 			return err
 		}
 	}
+	if ctx.Bool(PruneDryRunCommand.Name) {
+		if err = pruner.PruneDryRun(targetRoot); err != nil {
+			log.Error("Failed to count stale state", "err", err)
+			return err
+		}
+		return nil
+	}
 	if err = pruner.Prune(targetRoot); err != nil {
 		log.Error("Failed to prune state", "err", err)
 		return err
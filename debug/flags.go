@@ -155,9 +155,11 @@ var DeprecatedFlags = []cli.Flag{
 var glogger *log.GlogHandler
 
 func init() {
-	glogger = log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+	ostream := log.StreamHandler(os.Stderr, log.TerminalFormat(false))
+	glogger = log.NewGlogHandler(ostream)
 	glogger.Verbosity(log.LvlInfo)
-	log.Root().SetHandler(glogger)
+	modules = newModuleHandler(ostream, glogger)
+	log.Root().SetHandler(modules)
 }
 
 // Setup initializes profiling and logging based on the CLI flags.
@@ -175,6 +177,7 @@ func Setup(ctx *cli.Context) error {
 		ostream = log.StreamHandler(output, log.TerminalFormat(usecolor))
 	}
 	glogger.SetHandler(ostream)
+	modules.SetHandler(ostream)
 
 	// logging
 	verbosity := ctx.GlobalInt(verbosityFlag.Name)
@@ -202,7 +205,7 @@ func Setup(ctx *cli.Context) error {
 	}
 	glogger.BacktraceAt(backtrace)
 
-	log.Root().SetHandler(glogger)
+	log.Root().SetHandler(modules)
 
 	// profiling, tracing
 	runtime.MemProfileRate = memprofilerateFlag.Value
@@ -0,0 +1,139 @@
+package debug
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Fantom-foundation/go-opera/logger"
+)
+
+// modules is the root log handler, which applies per-module verbosity overrides
+var modules *moduleHandler
+
+// moduleHandler filters log records of modules with an overridden verbosity,
+// and passes the records of other modules to the glog handler.
+// Module of a record is taken from the "module" context key, see package logger.
+type moduleHandler struct {
+	mu       sync.RWMutex
+	levels   map[string]log.Lvl
+	origin   log.Handler
+	fallback log.Handler
+}
+
+func newModuleHandler(origin, fallback log.Handler) *moduleHandler {
+	return &moduleHandler{
+		levels:   make(map[string]log.Lvl),
+		origin:   origin,
+		fallback: fallback,
+	}
+}
+
+// SetHandler updates the handler to write records of modules with an overridden verbosity
+func (h *moduleHandler) SetHandler(origin log.Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.origin = origin
+}
+
+// Verbosity overrides the log verbosity of a module. Negative level removes the override.
+func (h *moduleHandler) Verbosity(module string, level log.Lvl) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if level < 0 {
+		delete(h.levels, module)
+		return
+	}
+	h.levels[module] = level
+}
+
+// Verbosities returns all the overridden module verbosities
+func (h *moduleHandler) Verbosities() map[string]log.Lvl {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	res := make(map[string]log.Lvl, len(h.levels))
+	for module, level := range h.levels {
+		res[module] = level
+	}
+	return res
+}
+
+// Log implements log.Handler
+func (h *moduleHandler) Log(r *log.Record) error {
+	h.mu.RLock()
+	if len(h.levels) != 0 {
+		for i := 0; i+1 < len(r.Ctx); i += 2 {
+			if key, ok := r.Ctx[i].(string); !ok || key != "module" {
+				continue
+			}
+			module, ok := r.Ctx[i+1].(string)
+			if !ok {
+				break
+			}
+			level, ok := h.levels[module]
+			if !ok {
+				break
+			}
+			origin := h.origin
+			h.mu.RUnlock()
+			if r.Lvl > level {
+				return nil
+			}
+			return origin.Log(r)
+		}
+	}
+	h.mu.RUnlock()
+	return h.fallback.Log(r)
+}
+
+// PrivateLogAPI provides an API to adjust logging at runtime.
+type PrivateLogAPI struct{}
+
+// ModuleVerbosity overrides the log verbosity of a module (e.g. "consensus" or "gossip-store"),
+// regardless of the global verbosity and vmodule settings. Negative level removes the override.
+// See LogModules for the list of known modules. Records logged without a module,
+// e.g. by go-ethereum packages, are affected only by the global settings.
+func (PrivateLogAPI) ModuleVerbosity(module string, level int) error {
+	if !logger.IsModule(module) {
+		return fmt.Errorf("unknown log module %q", module)
+	}
+	modules.Verbosity(module, log.Lvl(level))
+	log.Info("Module log verbosity changed", "target", module, "level", level)
+	return nil
+}
+
+// LogModules returns the names of all the modules which verbosity may be overridden.
+func (PrivateLogAPI) LogModules() []string {
+	return logger.Modules()
+}
+
+// ModuleVerbosities returns all the overridden module verbosities.
+func (PrivateLogAPI) ModuleVerbosities() map[string]int {
+	res := make(map[string]int)
+	for module, level := range modules.Verbosities() {
+		res[module] = int(level)
+	}
+	return res
+}
+
+// APIs returns the debugging APIs to be registered in the node.
+// Handler is registered after the node's own debug API, so it overrides the debug_* methods
+// of the go-ethereum handler, which adjust a log handler not used by Opera.
+func APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "debug",
+			Version:   "1.0",
+			Service:   Handler,
+			Public:    false,
+		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   PrivateLogAPI{},
+			Public:    false,
+		},
+	}
+}
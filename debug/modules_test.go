@@ -0,0 +1,87 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/logger"
+)
+
+func TestModuleHandler(t *testing.T) {
+	var origin, fallback []string
+	collect := func(into *[]string) log.Handler {
+		return log.FuncHandler(func(r *log.Record) error {
+			*into = append(*into, r.Msg)
+			return nil
+		})
+	}
+	glogger := log.NewGlogHandler(collect(&fallback))
+	glogger.Verbosity(log.LvlInfo)
+	h := newModuleHandler(collect(&origin), glogger)
+
+	root := log.New()
+	root.SetHandler(h)
+	store := root.New("module", "store")
+	other := root.New("module", "other")
+
+	reset := func() {
+		origin, fallback = nil, nil
+	}
+
+	t.Run("no override falls back to glog", func(t *testing.T) {
+		reset()
+		store.Info("info")
+		store.Debug("debug")
+		other.Info("other")
+		root.Info("root")
+		require.Empty(t, origin)
+		require.Equal(t, []string{"info", "other", "root"}, fallback)
+	})
+
+	t.Run("override raises verbosity", func(t *testing.T) {
+		reset()
+		h.Verbosity("store", log.LvlDebug)
+		store.Debug("debug")
+		store.Trace("trace")
+		other.Debug("other")
+		require.Equal(t, []string{"debug"}, origin)
+		require.Empty(t, fallback)
+	})
+
+	t.Run("override lowers verbosity", func(t *testing.T) {
+		reset()
+		h.Verbosity("store", log.LvlWarn)
+		store.Info("info")
+		store.Warn("warn")
+		other.Info("other")
+		require.Equal(t, []string{"warn"}, origin)
+		require.Equal(t, []string{"other"}, fallback)
+	})
+
+	t.Run("removed override falls back to glog", func(t *testing.T) {
+		reset()
+		h.Verbosity("store", -1)
+		store.Info("info")
+		store.Debug("debug")
+		require.Empty(t, origin)
+		require.Equal(t, []string{"info"}, fallback)
+		require.Empty(t, h.Verbosities())
+	})
+}
+
+func TestPrivateLogAPI_ModuleVerbosity(t *testing.T) {
+	require := require.New(t)
+	api := PrivateLogAPI{}
+
+	require.EqualError(api.ModuleVerbosity("unknown-test-module", int(log.LvlDebug)), `unknown log module "unknown-test-module"`)
+	require.NotContains(api.ModuleVerbosities(), "unknown-test-module")
+
+	logger.New("known-test-module")
+	require.Contains(api.LogModules(), "known-test-module")
+	require.NoError(api.ModuleVerbosity("known-test-module", int(log.LvlDebug)))
+	require.Equal(int(log.LvlDebug), api.ModuleVerbosities()["known-test-module"])
+	require.NoError(api.ModuleVerbosity("known-test-module", -1))
+	require.NotContains(api.ModuleVerbosities(), "known-test-module")
+}
@@ -1663,6 +1663,47 @@ func (s *PublicTransactionPoolAPI) GetRawTransactionByHash(ctx context.Context,
 	return tx.MarshalBinary()
 }
 
+// GetTransactionStatus returns the status of the transaction for the given hash:
+// "finalized" along with the block and event which contain the transaction,
+// "pending" if the transaction is in the pool, or "unknown" otherwise.
+// The hash returned by SendRawTransaction (i.e. the transaction hash) is the tracking ID.
+// Txs are indexed only by blocks, so a transaction which is already included into a connected event,
+// but not yet into a finalized block, is reported as "pending" only if it's in the local pool,
+// and as "unknown" otherwise (e.g. if the node has seen it only inside an event).
+// If the transactions index is disabled, only the pool is checked.
+func (s *PublicTransactionPoolAPI) GetTransactionStatus(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	tx, blockNumber, index, indexErr := s.b.GetTransaction(ctx, hash)
+	if indexErr == nil && tx != nil {
+		header, err := s.b.HeaderByNumber(ctx, rpc.BlockNumber(blockNumber))
+		if header == nil || err != nil {
+			return nil, err
+		}
+		fields := map[string]interface{}{
+			"status":           "finalized",
+			"blockHash":        header.Hash,
+			"blockNumber":      hexutil.Uint64(blockNumber),
+			"transactionIndex": hexutil.Uint64(index),
+			"event":            nil,
+		}
+		// txs which don't originate from events (e.g. internal txs) have no event
+		if position := s.b.GetTxPosition(hash); position != nil && !position.Event.IsZero() {
+			fields["event"] = hexutil.Bytes(position.Event.Bytes())
+		}
+		return fields, nil
+	}
+	if tx := s.b.GetPoolTransaction(hash); tx != nil {
+		return map[string]interface{}{
+			"status": "pending",
+		}, nil
+	}
+	if indexErr != nil {
+		return nil, indexErr
+	}
+	return map[string]interface{}{
+		"status": "unknown",
+	}, nil
+}
+
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
 func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
 	tx, blockNumber, index, err := s.b.GetTransaction(ctx, hash)
@@ -0,0 +1,96 @@
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/evmcore"
+	"github.com/Fantom-foundation/go-opera/gossip/evmstore"
+)
+
+// txStatusBackend stubs the Backend methods used by GetTransactionStatus
+type txStatusBackend struct {
+	Backend
+	indexErr  error
+	finalized map[common.Hash]*evmstore.TxPosition
+	pool      map[common.Hash]*types.Transaction
+}
+
+func (b *txStatusBackend) GetTransaction(ctx context.Context, txHash common.Hash) (*types.Transaction, uint64, uint64, error) {
+	if b.indexErr != nil {
+		return nil, 0, 0, b.indexErr
+	}
+	position, ok := b.finalized[txHash]
+	if !ok {
+		return nil, 0, 0, nil
+	}
+	return types.NewTransaction(0, common.Address{}, big.NewInt(0), 0, big.NewInt(0), nil), uint64(position.Block), uint64(position.BlockOffset), nil
+}
+
+func (b *txStatusBackend) GetTxPosition(txHash common.Hash) *evmstore.TxPosition {
+	return b.finalized[txHash]
+}
+
+func (b *txStatusBackend) GetPoolTransaction(txHash common.Hash) *types.Transaction {
+	return b.pool[txHash]
+}
+
+func (b *txStatusBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*evmcore.EvmHeader, error) {
+	return &evmcore.EvmHeader{Number: big.NewInt(number.Int64()), Hash: common.BigToHash(big.NewInt(number.Int64()))}, nil
+}
+
+func TestGetTransactionStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	finalized := common.HexToHash("0x01")
+	pending := common.HexToHash("0x02")
+	unknown := common.HexToHash("0x03")
+	event := hash.Event(common.HexToHash("0x04"))
+
+	b := &txStatusBackend{
+		finalized: map[common.Hash]*evmstore.TxPosition{
+			finalized: {Block: 5, Event: event, BlockOffset: 1},
+		},
+		pool: map[common.Hash]*types.Transaction{
+			pending: types.NewTransaction(0, common.Address{}, big.NewInt(0), 0, big.NewInt(0), nil),
+		},
+	}
+	api := &PublicTransactionPoolAPI{b: b}
+
+	status, err := api.GetTransactionStatus(ctx, finalized)
+	require.NoError(err)
+	require.Equal(map[string]interface{}{
+		"status":           "finalized",
+		"blockHash":        common.BigToHash(big.NewInt(5)),
+		"blockNumber":      hexutil.Uint64(5),
+		"transactionIndex": hexutil.Uint64(1),
+		"event":            hexutil.Bytes(event.Bytes()),
+	}, status)
+
+	status, err = api.GetTransactionStatus(ctx, pending)
+	require.NoError(err)
+	require.Equal(map[string]interface{}{"status": "pending"}, status)
+
+	status, err = api.GetTransactionStatus(ctx, unknown)
+	require.NoError(err)
+	require.Equal(map[string]interface{}{"status": "unknown"}, status)
+
+	// pool is still checked if transactions index is disabled
+	b.indexErr = errors.New("transactions index is disabled")
+	status, err = api.GetTransactionStatus(ctx, pending)
+	require.NoError(err)
+	require.Equal(map[string]interface{}{"status": "pending"}, status)
+
+	_, err = api.GetTransactionStatus(ctx, unknown)
+	require.Equal(b.indexErr, err)
+}
@@ -1,6 +1,8 @@
 package gossip
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -35,3 +37,48 @@ func (api *PublicEthereumAPI) Hashrate() hexutil.Uint64 {
 func (api *PublicEthereumAPI) ChainId() hexutil.Uint64 {
 	return hexutil.Uint64(api.s.store.GetRules().EvmChainConfig().ChainID.Uint64())
 }
+
+// PrivateAdminAPI provides an API to control the local node.
+type PrivateAdminAPI struct {
+	s *Service
+}
+
+// NewPrivateAdminAPI creates a new admin API for gossip.
+func NewPrivateAdminAPI(s *Service) *PrivateAdminAPI {
+	return &PrivateAdminAPI{s}
+}
+
+// PauseEmitting pauses creation of local events.
+// The node keeps processing and relaying events of other validators.
+func (api *PrivateAdminAPI) PauseEmitting() error {
+	if len(api.s.emitters) == 0 {
+		return errors.New("node isn't a validator")
+	}
+	for _, em := range api.s.emitters {
+		em.Pause()
+	}
+	api.s.Log.Info("Events emitting is paused")
+	return nil
+}
+
+// ResumeEmitting resumes creation of local events paused by PauseEmitting.
+func (api *PrivateAdminAPI) ResumeEmitting() error {
+	if len(api.s.emitters) == 0 {
+		return errors.New("node isn't a validator")
+	}
+	for _, em := range api.s.emitters {
+		em.Resume()
+	}
+	api.s.Log.Info("Events emitting is resumed")
+	return nil
+}
+
+// EmittingPaused returns true if creation of local events is paused by PauseEmitting.
+func (api *PrivateAdminAPI) EmittingPaused() bool {
+	for _, em := range api.s.emitters {
+		if em.IsPaused() {
+			return true
+		}
+	}
+	return false
+}
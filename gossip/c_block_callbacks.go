@@ -15,7 +15,6 @@ import (
 	"github.com/Fantom-foundation/lachesis-base/utils/workers"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
 
 	"github.com/Fantom-foundation/go-opera/evmcore"
@@ -24,7 +23,9 @@ import (
 	"github.com/Fantom-foundation/go-opera/gossip/evmstore"
 	"github.com/Fantom-foundation/go-opera/inter"
 	"github.com/Fantom-foundation/go-opera/inter/iblockproc"
+	"github.com/Fantom-foundation/go-opera/logger"
 	"github.com/Fantom-foundation/go-opera/opera"
+	"github.com/Fantom-foundation/go-opera/tracing"
 	"github.com/Fantom-foundation/go-opera/utils"
 )
 
@@ -60,6 +61,9 @@ var (
 	_ = metrics.GetOrRegisterMeter("chain/reorg/invalidTx", nil)
 )
 
+// consensusLog is the logger of consensus callbacks, i.e. of blocks decided by the engine
+var consensusLog = logger.New("consensus").Log
+
 type ExtendedTxPosition struct {
 	evmstore.TxPosition
 	EventCreator idx.ValidatorID
@@ -111,7 +115,7 @@ func consensusCallbackBeginBlockFn(
 		// Get stateDB
 		statedb, err := store.evm.StateDB(bs.FinalizedStateRoot)
 		if err != nil {
-			log.Crit("Failed to open StateDB", "err", err)
+			consensusLog.Crit("Failed to open StateDB", "err", err)
 		}
 		evmStateReader := &EvmStateReader{
 			ServiceFeed: feed,
@@ -228,7 +232,7 @@ func consensusCallbackBeginBlockFn(
 				if skipBlock {
 					// save the latest block state even if block is skipped
 					store.SetBlockEpochState(bs, es)
-					log.Debug("Frame is skipped", "atropos", cBlock.Atropos.String())
+					consensusLog.Debug("Frame is skipped", "atropos", cBlock.Atropos.String())
 					return nil
 				}
 
@@ -264,7 +268,7 @@ func consensusCallbackBeginBlockFn(
 				bs = txListener.Finalize()
 				for _, r := range preInternalReceipts {
 					if r.Status == 0 {
-						log.Warn("Pre-internal transaction reverted", "txid", r.TxHash.String())
+						consensusLog.Warn("Pre-internal transaction reverted", "txid", r.TxHash.String())
 					}
 				}
 
@@ -284,7 +288,7 @@ func consensusCallbackBeginBlockFn(
 					internalReceipts := evmProcessor.Execute(internalTxs)
 					for _, r := range internalReceipts {
 						if r.Status == 0 {
-							log.Warn("Internal transaction reverted", "txid", r.TxHash.String())
+							consensusLog.Warn("Internal transaction reverted", "txid", r.TxHash.String())
 						}
 					}
 
@@ -405,6 +409,9 @@ func consensusCallbackBeginBlockFn(
 					headHeaderGauge.Update(int64(blockCtx.Idx))
 					headFastBlockGauge.Update(int64(blockCtx.Idx))
 
+					// Finish the tracing spans of processed txs
+					tracing.FinishTxs(evmBlock.Transactions, "Service.ProcessBlock()")
+
 					// Notify about new block
 					if feed != nil {
 						feed.newBlock.Send(evmcore.ChainHeadNotify{Block: evmBlock})
@@ -426,7 +433,7 @@ func consensusCallbackBeginBlockFn(
 					blockInsertTimer.UpdateSince(start)
 
 					now := time.Now()
-					log.Info("New block", "index", blockCtx.Idx, "id", block.Atropos, "gas_used",
+					consensusLog.Info("New block", "index", blockCtx.Idx, "id", block.Atropos, "gas_used",
 						evmBlock.GasUsed, "txs", fmt.Sprintf("%d/%d", len(evmBlock.Transactions), len(block.SkippedTxs)),
 						"age", utils.PrettyDuration(now.Sub(block.Time.Time())), "t", utils.PrettyDuration(now.Sub(start)))
 				}
@@ -463,7 +470,7 @@ func spillBlockEvents(store *Store, block *inter.Block, network opera.Rules) (*i
 		id := block.Events[i]
 		e := store.GetEventPayload(id)
 		if e == nil {
-			log.Crit("Block event not found", "event", id.String())
+			consensusLog.Crit("Block event not found", "event", id.String())
 		}
 		fullEvents[i] = e
 		gasPowerUsedSum += e.GasPowerUsed()
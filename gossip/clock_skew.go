@@ -0,0 +1,83 @@
+package gossip
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/Fantom-foundation/go-opera/logger"
+)
+
+const (
+	// clockSkewMaxEventAge is the max age of an event to be taken into account.
+	// Older events are likely to be received during the sync rather than gossiped
+	clockSkewMaxEventAge = 30 * time.Second
+	// clockSkewSmoothing is a reciprocal of the exponential moving average factor
+	clockSkewSmoothing = 8
+)
+
+// clockSkews maintains per-creator estimations of a difference between the claimed
+// event creation time and the local time when the event started being processed,
+// i.e. after it was queued and its missing parents were received.
+// Positive skew means that creator's clock is ahead of the local clock.
+// Note that skew estimations include the network propagation and the local queueing delays.
+type clockSkews struct {
+	threshold time.Duration
+
+	mu     sync.Mutex
+	skews  map[idx.ValidatorID]time.Duration
+	gauges map[idx.ValidatorID]metrics.Gauge
+
+	logger.Periodic
+}
+
+func newClockSkews(threshold time.Duration) *clockSkews {
+	return &clockSkews{
+		threshold: threshold,
+		skews:     make(map[idx.ValidatorID]time.Duration),
+		gauges:    make(map[idx.ValidatorID]metrics.Gauge),
+		Periodic:  logger.Periodic{Instance: logger.New("clock-skews")},
+	}
+}
+
+// Observe updates skew estimation of the creator with an event which started being processed at the local time
+func (c *clockSkews) Observe(creator idx.ValidatorID, created time.Time, processed time.Time) {
+	diff := created.Sub(processed)
+	if diff < -clockSkewMaxEventAge {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	skew, ok := c.skews[creator]
+	if !ok {
+		skew = diff
+	} else {
+		skew += (diff - skew) / clockSkewSmoothing
+	}
+	c.skews[creator] = skew
+
+	gauge, ok := c.gauges[creator]
+	if !ok {
+		gauge = metrics.GetOrRegisterGauge(fmt.Sprintf("gossip/clockskew/%d", creator), nil)
+		c.gauges[creator] = gauge
+	}
+	gauge.Update(skew.Milliseconds())
+
+	if c.threshold != 0 && (skew > c.threshold || skew < -c.threshold) {
+		c.Periodic.Warn(time.Minute, "Validator clock is skewed", "validator", creator, "skew", skew)
+	}
+}
+
+// Get returns skew estimation of the creator
+func (c *clockSkews) Get(creator idx.ValidatorID) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	skew, ok := c.skews[creator]
+	return skew, ok
+}
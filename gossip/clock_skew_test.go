@@ -0,0 +1,37 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSkews(t *testing.T) {
+	require := require.New(t)
+
+	c := newClockSkews(time.Second)
+	now := time.Unix(1000, 0)
+
+	_, ok := c.Get(1)
+	require.False(ok)
+
+	// first observation is taken as is
+	c.Observe(1, now.Add(2*time.Second), now)
+	skew, ok := c.Get(1)
+	require.True(ok)
+	require.Equal(2*time.Second, skew)
+
+	// next observations are smoothed
+	c.Observe(1, now.Add(10*time.Second), now)
+	skew, _ = c.Get(1)
+	require.Equal(3*time.Second, skew)
+
+	// old events are ignored
+	c.Observe(2, now.Add(-time.Hour), now)
+	_, ok = c.Get(2)
+	require.False(ok)
+	c.Observe(2, now.Add(-time.Second), now)
+	skew, _ = c.Get(2)
+	require.Equal(-time.Second, skew)
+}
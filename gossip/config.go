@@ -46,6 +46,9 @@ type (
 
 		ProgressBroadcastPeriod time.Duration
 
+		// ClockSkewWarnThreshold is a max estimated clock skew of an event creator before a warning is logged, 0 to disable
+		ClockSkewWarnThreshold time.Duration
+
 		DagProcessor dagprocessor.Config
 		BvProcessor  bvprocessor.Config
 		BrProcessor  brprocessor.Config
@@ -162,6 +165,7 @@ func DefaultConfig(scale cachescale.Func) Config {
 			},
 			MsgsSemaphoreTimeout:    10 * time.Second,
 			ProgressBroadcastPeriod: 10 * time.Second,
+			ClockSkewWarnThreshold:  5 * time.Second,
 
 			DagProcessor: dagprocessor.DefaultConfig(scale),
 			BvProcessor:  bvprocessor.DefaultConfig(scale),
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Fantom-foundation/lachesis-base/emitter/ancestor"
@@ -67,6 +68,8 @@ type Emitter struct {
 	done chan struct{}
 	wg   sync.WaitGroup
 
+	paused uint32
+
 	maxParents idx.Event
 
 	cache struct {
@@ -101,7 +104,7 @@ func NewEmitter(
 		originatedTxs: originatedtxs.New(SenderCountBufferSize),
 		txTime:        txTime,
 		intervals:     config.EmitIntervals,
-		Periodic:      logger.Periodic{Instance: logger.New()},
+		Periodic:      logger.Periodic{Instance: logger.New("emitter")},
 	}
 }
 
@@ -176,6 +179,23 @@ func (em *Emitter) Stop() {
 	em.busyRate.Stop()
 }
 
+// Pause pauses event emission until Resume is called.
+// Events of other validators are still processed while emitting is paused.
+func (em *Emitter) Pause() {
+	atomic.StoreUint32(&em.paused, 1)
+}
+
+// Resume resumes event emission paused by Pause.
+// Next event will use the last emitted event as a self-parent.
+func (em *Emitter) Resume() {
+	atomic.StoreUint32(&em.paused, 0)
+}
+
+// IsPaused returns true if event emission is paused by Pause.
+func (em *Emitter) IsPaused() bool {
+	return atomic.LoadUint32(&em.paused) != 0
+}
+
 func (em *Emitter) tick() {
 	// track synced time
 	if em.world.PeersNum() == 0 {
@@ -197,6 +217,10 @@ func (em *Emitter) tick() {
 
 	em.recheckChallenges()
 	em.recheckIdleTime()
+	if em.IsPaused() {
+		em.Periodic.Info(7*time.Second, "Emitting is paused", "reason", "paused via admin API")
+		return
+	}
 	if time.Since(em.prevEmittedAtTime) >= em.intervals.Min {
 		_, _ = em.EmitEvent()
 	}
@@ -1,6 +1,7 @@
 package emitter
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -22,7 +23,9 @@ import (
 
 //go:generate go run github.com/golang/mock/mockgen -package=mock -destination=mock/world.go github.com/Fantom-foundation/go-opera/gossip/emitter External,TxPool,TxSigner,Signer
 
-func TestEmitter(t *testing.T) {
+// newTestEmitter creates an emitter of the first of 3 validators over mocks,
+// with isBusy defining whether the node is busy
+func newTestEmitter(t *testing.T, isBusy func() bool) (*Emitter, *mock.MockExternal, *mock.MockTxPool) {
 	cfg := DefaultConfig()
 	gValidators := makefakegenesis.GetFakeValidators(3)
 	vv := pos.NewBuilder()
@@ -33,6 +36,7 @@ func TestEmitter(t *testing.T) {
 	cfg.Validator.ID = gValidators[0].ID
 
 	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
 	external := mock.NewMockExternal(ctrl)
 	txPool := mock.NewMockTxPool(ctrl)
 	signer := mock.NewMockSigner(ctrl)
@@ -51,6 +55,22 @@ func TestEmitter(t *testing.T) {
 	external.EXPECT().PeersNum().
 		Return(int(3)).
 		AnyTimes()
+	external.EXPECT().IsBusy().
+		DoAndReturn(isBusy).
+		AnyTimes()
+
+	external.EXPECT().GetRules().
+		Return(opera.FakeNetRules()).
+		AnyTimes()
+	external.EXPECT().GetEpochValidators().
+		Return(validators, idx.Epoch(1)).
+		AnyTimes()
+	external.EXPECT().GetLastEvent(idx.Epoch(1), cfg.Validator.ID).
+		Return((*hash.Event)(nil)).
+		AnyTimes()
+	external.EXPECT().GetGenesisTime().
+		Return(inter.Timestamp(uint64(time.Now().UnixNano()))).
+		AnyTimes()
 
 	em := NewEmitter(cfg, World{
 		External: external,
@@ -58,24 +78,15 @@ func TestEmitter(t *testing.T) {
 		Signer:   signer,
 		TxSigner: txSigner,
 	})
+	return em, external, txPool
+}
 
-	t.Run("init", func(t *testing.T) {
-		external.EXPECT().GetRules().
-			Return(opera.FakeNetRules()).
-			AnyTimes()
-
-		external.EXPECT().GetEpochValidators().
-			Return(validators, idx.Epoch(1)).
-			AnyTimes()
-
-		external.EXPECT().GetLastEvent(idx.Epoch(1), cfg.Validator.ID).
-			Return((*hash.Event)(nil)).
-			AnyTimes()
-
-		external.EXPECT().GetGenesisTime().
-			Return(inter.Timestamp(uint64(time.Now().UnixNano()))).
-			AnyTimes()
+func TestEmitter(t *testing.T) {
+	em, _, _ := newTestEmitter(t, func() bool {
+		return true
+	})
 
+	t.Run("init", func(t *testing.T) {
 		em.init()
 	})
 
@@ -83,10 +94,6 @@ func TestEmitter(t *testing.T) {
 		require := require.New(t)
 		tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 1, big.NewInt(1), nil)
 
-		external.EXPECT().IsBusy().
-			Return(true).
-			AnyTimes()
-
 		_, ok := em.txTime.Get(tx.Hash())
 		require.False(ok)
 
@@ -105,3 +112,37 @@ func TestEmitter(t *testing.T) {
 		em.tick()
 	})
 }
+
+func TestEmitterPause(t *testing.T) {
+	require := require.New(t)
+
+	busy := false
+	em, _, txPool := newTestEmitter(t, func() bool {
+		return busy
+	})
+	em.init()
+
+	require.False(em.IsPaused())
+	em.Pause()
+	require.True(em.IsPaused())
+
+	// emission isn't reached while paused, i.e. txpool isn't touched
+	em.tick()
+	em.tick()
+	require.True(em.prevEmittedAtTime.IsZero())
+
+	em.Resume()
+	require.False(em.IsPaused())
+
+	// emission is reached after resuming, stop it once txpool is touched
+	txPool.EXPECT().Count().
+		Return(0).
+		Times(1)
+	txPool.EXPECT().Pending(true).
+		DoAndReturn(func(bool) (map[common.Address]types.Transactions, error) {
+			busy = true
+			return nil, errors.New("stop emission")
+		}).
+		Times(1)
+	em.tick()
+}
@@ -2,6 +2,7 @@ package gossip
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -149,12 +150,27 @@ func decodeShortEventID(s []string) (idx.Epoch, idx.Lamport, []byte, error) {
 	return idx.Epoch(epoch), idx.Lamport(lamport), common.FromHex(s[2]), nil
 }
 
-// GetFullEventID "converts" ShortID to full event's hash, by searching in events DB.
+// GetFullEventID "converts" ShortID or hash prefix to full event's hash, by searching in events DB.
 func (b *EthAPIBackend) GetFullEventID(shortEventID string) (hash.Event, error) {
 	s := strings.Split(shortEventID, ":")
 	if len(s) == 1 {
-		// it's a full hash
-		return hash.HexToEventHash(shortEventID), nil
+		hexID := shortEventID
+		if strings.HasPrefix(hexID, "0x") || strings.HasPrefix(hexID, "0X") {
+			hexID = hexID[2:]
+		}
+		if len(hexID) > 2*len(hash.Event{}) {
+			return hash.Event{}, errors.New("event ID is too long")
+		}
+		if len(hexID) == 2*len(hash.Event{}) {
+			// it's a full hash
+			id, err := hex.DecodeString(hexID)
+			if err != nil {
+				return hash.Event{}, errors.Wrap(err, "event ID parsing error")
+			}
+			return hash.BytesToEvent(id), nil
+		}
+		// it's a hash prefix
+		return b.resolveEventIDPrefix(hexID)
 	}
 	// short hash
 	epoch, lamport, prefix, err := decodeShortEventID(s)
@@ -172,7 +188,34 @@ func (b *EthAPIBackend) GetFullEventID(shortEventID string) (hash.Event, error)
 	return options[0], nil
 }
 
-// GetEventPayload returns Lachesis event by hash or short ID.
+// minEventIDPrefixLen is a minimum number of hex digits of an event ID prefix, i.e. the epoch.
+// Shorter prefixes would match events of many epochs.
+const minEventIDPrefixLen = 8
+
+// resolveEventIDPrefix "converts" a unique hex prefix of event's hash (like a git short hash) to full event's hash.
+// example of a prefix: "000000050000001aa2395846", the number of hex digits may be odd
+func (b *EthAPIBackend) resolveEventIDPrefix(hexPrefix string) (hash.Event, error) {
+	if len(hexPrefix) < minEventIDPrefixLen {
+		return hash.Event{}, fmt.Errorf("event ID prefix must have at least %d hex digits", minEventIDPrefixLen)
+	}
+	hexPrefix = strings.ToLower(hexPrefix)
+	// pad an odd prefix only to validate it
+	_, err := hex.DecodeString(hexPrefix + strings.Repeat("0", len(hexPrefix)%2))
+	if err != nil {
+		return hash.Event{}, errors.Wrap(err, "event ID prefix parsing error")
+	}
+
+	options := b.svc.store.FindEventHashesByHexPrefix(hexPrefix, 2)
+	if len(options) == 0 {
+		return hash.Event{}, errors.New("event not found by ID prefix")
+	}
+	if len(options) > 1 {
+		return hash.Event{}, errors.New("there're multiple events with the same ID prefix, please use longer prefix")
+	}
+	return options[0], nil
+}
+
+// GetEventPayload returns Lachesis event by hash, hash prefix or short ID.
 func (b *EthAPIBackend) GetEventPayload(ctx context.Context, shortEventID string) (*inter.EventPayload, error) {
 	id, err := b.GetFullEventID(shortEventID)
 	if err != nil {
@@ -181,7 +224,7 @@ func (b *EthAPIBackend) GetEventPayload(ctx context.Context, shortEventID string
 	return b.svc.store.GetEventPayload(id), nil
 }
 
-// GetEvent returns the Lachesis event header by hash or short ID.
+// GetEvent returns the Lachesis event header by hash, hash prefix or short ID.
 func (b *EthAPIBackend) GetEvent(ctx context.Context, shortEventID string) (*inter.Event, error) {
 	id, err := b.GetFullEventID(shortEventID)
 	if err != nil {
@@ -0,0 +1,81 @@
+package gossip
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/inter"
+	"github.com/Fantom-foundation/go-opera/logger"
+)
+
+func TestEthAPIBackend_GetFullEventID(t *testing.T) {
+	logger.SetTestMode(t)
+	require := require.New(t)
+
+	store := NewMemStore()
+	defer store.Close()
+	b := &EthAPIBackend{svc: &Service{store: store}}
+
+	ids := make(hash.Events, 0, 4)
+	for i := 0; i < 4; i++ {
+		me := &inter.MutableEventPayload{}
+		me.SetVersion(1)
+		me.SetEpoch(idx.Epoch(1 + i/2))
+		me.SetLamport(idx.Lamport(1 + i%2))
+		me.SetSeq(idx.Event(1 + i))
+		me.SetCreator(1)
+		e := me.Build()
+		store.SetEvent(e)
+		ids = append(ids, e.ID())
+	}
+	hexID := func(id hash.Event) string {
+		return id.Hex()[2:]
+	}
+
+	t.Run("full ID is passed through", func(t *testing.T) {
+		unknown := hash.HexToEventHash("0x0000000a0000000b" + strings.Repeat("ab", 24))
+		for _, s := range []string{unknown.Hex(), "0X" + hexID(unknown), hexID(unknown)} {
+			id, err := b.GetFullEventID(s)
+			require.NoError(err, s)
+			require.Equal(unknown, id, s)
+		}
+	})
+
+	t.Run("unique prefix", func(t *testing.T) {
+		for _, s := range []string{
+			hexID(ids[2])[:20],
+			hexID(ids[2])[:21],
+			"0x" + hexID(ids[2])[:63],
+			"0X" + strings.ToUpper(hexID(ids[2])[:19]),
+		} {
+			id, err := b.GetFullEventID(s)
+			require.NoError(err, s)
+			require.Equal(ids[2], id, s)
+		}
+	})
+
+	t.Run("ambiguous prefix", func(t *testing.T) {
+		for _, s := range []string{"00000001", "0x000000010"} {
+			_, err := b.GetFullEventID(s)
+			require.EqualError(err, "there're multiple events with the same ID prefix, please use longer prefix", s)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		for _, s := range []string{"00000003", "000000030", "10000000"} {
+			_, err := b.GetFullEventID(s)
+			require.EqualError(err, "event not found by ID prefix", s)
+		}
+	})
+
+	t.Run("malformed prefix", func(t *testing.T) {
+		for _, s := range []string{"", "0x", "1", "0000000", "0000000g", "00000000g", strings.Repeat("g", 64), strings.Repeat("0", 66)} {
+			_, err := b.GetFullEventID(s)
+			require.Error(err, s)
+		}
+	})
+}
@@ -108,17 +108,84 @@ func NewPruner(db ethdb.Database, genesisRoot, root common.Hash, datadir string,
 	}, nil
 }
 
-func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, stateBloom StateBloom, bloomPath string, middleStateRoots map[common.Hash]struct{}, start time.Time) error {
-	// Delete all stale trie nodes in the disk. With the help of state bloom
-	// the trie nodes(and codes) belong to the active state will be filtered
-	// out. A very small part of stale tries will also be filtered because of
-	// the false-positive rate of bloom filter. But the assumption is held here
-	// that the false-positive is low enough(~0.05%). The probablity of the
-	// dangling node is the state root is super low. So the dangling nodes in
-	// theory will never ever be visited again.
+// pruningStats counts stale state entries per class
+type pruningStats struct {
+	nodes     int
+	nodesSize common.StorageSize
+	codes     int
+	codesSize common.StorageSize
+}
+
+func (s *pruningStats) add(isCode bool, size common.StorageSize) {
+	if isCode {
+		s.codes++
+		s.codesSize += size
+	} else {
+		s.nodes++
+		s.nodesSize += size
+	}
+}
+
+func (s *pruningStats) count() int {
+	return s.nodes + s.codes
+}
+
+func (s *pruningStats) size() common.StorageSize {
+	return s.nodesSize + s.codesSize
+}
+
+// isStaleStateKey returns true if the key is a state entry (trie node or contract code)
+// which doesn't belong to the target and genesis states
+func isStaleStateKey(key []byte, stateBloom StateBloom, middleStateRoots map[common.Hash]struct{}) (stale bool, isCode bool, err error) {
+	isCode, codeKey := rawdb.IsCodeKey(key)
+	if len(key) != common.HashLength && !isCode {
+		return false, false, nil
+	}
+	checkKey := key
+	if isCode {
+		checkKey = codeKey
+	}
+	if _, exist := middleStateRoots[common.BytesToHash(checkKey)]; exist {
+		return true, isCode, nil
+	}
+	ok, err := stateBloom.Contain(checkKey)
+	if err != nil {
+		return false, false, err
+	}
+	return !ok, isCode, nil
+}
+
+// countStaleState counts the state entries which deleteStaleState would delete
+func countStaleState(maindb ethdb.Database, stateBloom StateBloom, middleStateRoots map[common.Hash]struct{}) (pruningStats, error) {
 	var (
-		count  int
-		size   common.StorageSize
+		stats  pruningStats
+		start  = time.Now()
+		logged = time.Now()
+		iter   = maindb.NewIterator(nil, nil)
+	)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		stale, isCode, err := isStaleStateKey(key, stateBloom, middleStateRoots)
+		if err != nil {
+			return stats, err
+		}
+		if stale {
+			stats.add(isCode, common.StorageSize(len(key)+len(iter.Value())))
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Counting stale state data", "nodes", stats.nodes, "codes", stats.codes, "size", stats.size(),
+				"elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	return stats, iter.Error()
+}
+
+// deleteStaleState deletes all the state entries which don't belong to the target and genesis states
+func deleteStaleState(maindb ethdb.Database, stateBloom StateBloom, middleStateRoots map[common.Hash]struct{}) (pruningStats, error) {
+	var (
+		stats  pruningStats
 		pstart = time.Now()
 		logged = time.Now()
 		batch  = maindb.NewBatch()
@@ -131,23 +198,16 @@ func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, sta
 		// - trie node
 		// - legacy contract code
 		// - new-scheme contract code
-		isCode, codeKey := rawdb.IsCodeKey(key)
-		if len(key) == common.HashLength || isCode {
-			checkKey := key
-			if isCode {
-				checkKey = codeKey
-			}
-			if _, exist := middleStateRoots[common.BytesToHash(checkKey)]; exist {
-				log.Debug("Forcibly delete the middle state roots", "hash", common.BytesToHash(checkKey))
-			} else {
-				if ok, err := stateBloom.Contain(checkKey); err != nil {
-					return err
-				} else if ok {
-					continue
-				}
+		stale, isCode, err := isStaleStateKey(key, stateBloom, middleStateRoots)
+		if err != nil {
+			iter.Release()
+			return stats, err
+		}
+		if stale {
+			if _, exist := middleStateRoots[common.BytesToHash(key)]; exist && !isCode {
+				log.Debug("Forcibly delete the middle state roots", "hash", common.BytesToHash(key))
 			}
-			count += 1
-			size += common.StorageSize(len(key) + len(iter.Value()))
+			stats.add(isCode, common.StorageSize(len(key)+len(iter.Value())))
 			batch.Delete(key)
 
 			var eta time.Duration // Realistically will never remain uninited
@@ -159,7 +219,7 @@ func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, sta
 				eta = time.Duration(left/speed) * time.Millisecond
 			}
 			if time.Since(logged) > 8*time.Second {
-				log.Info("Pruning state data", "nodes", count, "size", size,
+				log.Info("Pruning state data", "nodes", stats.nodes, "codes", stats.codes, "size", stats.size(),
 					"elapsed", common.PrettyDuration(time.Since(pstart)), "eta", common.PrettyDuration(eta))
 				logged = time.Now()
 			}
@@ -179,7 +239,23 @@ func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, sta
 		batch.Reset()
 	}
 	iter.Release()
-	log.Info("Pruned state data", "nodes", count, "size", size, "elapsed", common.PrettyDuration(time.Since(pstart)))
+	log.Info("Pruned state data", "nodes", stats.nodes, "nodes_size", stats.nodesSize, "codes", stats.codes, "codes_size", stats.codesSize,
+		"elapsed", common.PrettyDuration(time.Since(pstart)))
+	return stats, nil
+}
+
+func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, stateBloom StateBloom, bloomPath string, middleStateRoots map[common.Hash]struct{}, start time.Time) error {
+	// Delete all stale trie nodes in the disk. With the help of state bloom
+	// the trie nodes(and codes) belong to the active state will be filtered
+	// out. A very small part of stale tries will also be filtered because of
+	// the false-positive rate of bloom filter. But the assumption is held here
+	// that the false-positive is low enough(~0.05%). The probablity of the
+	// dangling node is the state root is super low. So the dangling nodes in
+	// theory will never ever be visited again.
+	stats, err := deleteStaleState(maindb, stateBloom, middleStateRoots)
+	if err != nil {
+		return err
+	}
 
 	// Secondly, flushing the snapshot journal into the disk. All diff
 	// layers upon are dropped silently. Eventually the entire snapshot
@@ -196,7 +272,7 @@ func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, sta
 
 	// Start compactions, will remove the deleted data from the disk immediately.
 	// Note for small pruning, the compaction is skipped.
-	if count >= rangeCompactionThreshold {
+	if stats.count() >= rangeCompactionThreshold {
 		cstart := time.Now()
 		for b := 0x00; b <= 0xf0; b += 0x10 {
 			var (
@@ -214,7 +290,7 @@ func prune(snaptree *snapshot.Tree, root common.Hash, maindb ethdb.Database, sta
 		}
 		log.Info("Database compaction finished", "elapsed", common.PrettyDuration(time.Since(cstart)))
 	}
-	log.Info("State pruning successful", "pruned", size, "elapsed", common.PrettyDuration(time.Since(start)))
+	log.Info("State pruning successful", "pruned", stats.size(), "elapsed", common.PrettyDuration(time.Since(start)))
 	return nil
 }
 
@@ -233,6 +309,48 @@ func (p *Pruner) Prune(root common.Hash) error {
 	if stateBloomRoot != (common.Hash{}) {
 		return RecoverPruning(p.datadir, p.db, p.root)
 	}
+	start := time.Now()
+	root, middleRoots, err := p.fillStateBloom(root)
+	if err != nil {
+		return err
+	}
+	filterName := bloomFilterName(p.datadir, root)
+
+	if err := p.stateBloom.Commit(filterName, filterName+stateBloomFileTempSuffix); err != nil {
+		return err
+	}
+	return prune(p.snaptree, root, p.db, p.stateBloom, filterName, middleRoots, start)
+}
+
+// PruneDryRun reports the number and size of trie nodes and contract codes which Prune
+// would delete, without deleting anything.
+func (p *Pruner) PruneDryRun(root common.Hash) error {
+	_, stateBloomRoot, err := findBloomFilter(p.datadir)
+	if err != nil {
+		return err
+	}
+	if stateBloomRoot != (common.Hash{}) {
+		return errors.New("previous pruning was interrupted, it has to be finished first")
+	}
+	start := time.Now()
+	root, middleRoots, err := p.fillStateBloom(root)
+	if err != nil {
+		return err
+	}
+
+	stats, err := countStaleState(p.db, p.stateBloom, middleRoots)
+	if err != nil {
+		return err
+	}
+	log.Info("State pruning dry run finished", "root", root,
+		"nodes", stats.nodes, "nodes_size", stats.nodesSize, "codes", stats.codes, "codes_size", stats.codesSize,
+		"elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// fillStateBloom puts the target and genesis states into the state bloom.
+// It returns the target root and the state roots of the middle layers.
+func (p *Pruner) fillStateBloom(root common.Hash) (common.Hash, map[common.Hash]struct{}, error) {
 	// If the target state root is not specified, use the HEAD-127 as the
 	// target. The reason for picking it is:
 	// - in most of the normal cases, the related state is available
@@ -247,7 +365,7 @@ func (p *Pruner) Prune(root common.Hash) error {
 			// Reject if the accumulated diff layers are less than 128. It
 			// means in most of normal cases, there is no associated state
 			// with bottom-most diff layer.
-			return fmt.Errorf("snapshot not old enough yet: need %d more blocks", 1)
+			return common.Hash{}, nil, fmt.Errorf("snapshot not old enough yet: need %d more blocks", 1)
 		}
 		// Use the bottom-most diff layer as the target
 		root = layers[len(layers)-1].Root()
@@ -264,21 +382,15 @@ func (p *Pruner) Prune(root common.Hash) error {
 	}
 	// Traverse the target state, re-construct the whole state trie and
 	// commit to the given bloom filter.
-	start := time.Now()
 	if err := snapshot.GenerateTrie(p.snaptree, root, p.db, p.stateBloom); err != nil {
-		return err
+		return common.Hash{}, nil, err
 	}
 	// Traverse the genesis, put all genesis state entries into the
 	// bloom filter too.
 	if err := extractGenesis(p.db, p.genesisRoot, p.stateBloom); err != nil {
-		return err
+		return common.Hash{}, nil, err
 	}
-	filterName := bloomFilterName(p.datadir, root)
-
-	if err := p.stateBloom.Commit(filterName, filterName+stateBloomFileTempSuffix); err != nil {
-		return err
-	}
-	return prune(p.snaptree, root, p.db, p.stateBloom, filterName, middleRoots, start)
+	return root, middleRoots, nil
 }
 
 // RecoverPruning will resume the pruning procedure during the system restart.
@@ -0,0 +1,67 @@
+package evmpruner
+
+import (
+	"testing"
+
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/stretchr/testify/require"
+)
+
+func dumpDB(db ethdb.Database) map[string]string {
+	res := make(map[string]string)
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		res[string(it.Key())] = string(it.Value())
+	}
+	return res
+}
+
+func TestPruneDryRunCountsDeletedEntries(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		keptNode   = common.HexToHash("0x01")
+		staleNode  = common.HexToHash("0x02")
+		middleRoot = common.HexToHash("0x03")
+		keptCode   = common.HexToHash("0x04")
+		staleCode  = common.HexToHash("0x05")
+	)
+	maindb := rawdb.NewMemoryDatabase()
+	require.NoError(maindb.Put(keptNode.Bytes(), []byte("kept node")))
+	require.NoError(maindb.Put(staleNode.Bytes(), []byte("stale node")))
+	require.NoError(maindb.Put(middleRoot.Bytes(), []byte("middle root")))
+	rawdb.WriteCode(maindb, keptCode, []byte("kept code"))
+	rawdb.WriteCode(maindb, staleCode, []byte("stale code"))
+	// not a state entry
+	require.NoError(maindb.Put([]byte("other"), []byte("other")))
+
+	stateBloom := &exactSetStore{memorydb.New()}
+	for _, key := range [][]byte{keptNode.Bytes(), middleRoot.Bytes(), keptCode.Bytes()} {
+		require.NoError(stateBloom.Put(key, nil))
+	}
+	middleRoots := map[common.Hash]struct{}{middleRoot: {}}
+
+	before := dumpDB(maindb)
+	dryRun, err := countStaleState(maindb, stateBloom, middleRoots)
+	require.NoError(err)
+	require.Equal(before, dumpDB(maindb))
+	require.Equal(2, dryRun.nodes)
+	require.Equal(common.StorageSize(2*common.HashLength+len("stale node")+len("middle root")), dryRun.nodesSize)
+	require.Equal(1, dryRun.codes)
+	require.Equal(common.StorageSize(len(rawdb.CodePrefix)+common.HashLength+len("stale code")), dryRun.codesSize)
+
+	pruned, err := deleteStaleState(maindb, stateBloom, middleRoots)
+	require.NoError(err)
+	require.Equal(dryRun, pruned)
+
+	after := dumpDB(maindb)
+	require.Len(after, len(before)-dryRun.count())
+	require.Contains(after, string(keptNode.Bytes()))
+	require.Contains(after, "other")
+	require.NotNil(rawdb.ReadCode(maindb, keptCode))
+	require.Nil(rawdb.ReadCode(maindb, staleCode))
+}
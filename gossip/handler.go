@@ -160,6 +160,8 @@ type handler struct {
 
 	checkers *eventcheck.Checkers
 
+	clockSkews *clockSkews
+
 	msgSemaphore *datasemaphore.DataSemaphore
 
 	store    *Store
@@ -209,6 +211,7 @@ func newHandler(
 		store:                c.s,
 		process:              c.process,
 		checkers:             c.checkers,
+		clockSkews:           newClockSkews(c.config.Protocol.ClockSkewWarnThreshold),
 		peers:                newPeerSet(),
 		engineMu:             c.engineMu,
 		txsyncCh:             make(chan *txsync),
@@ -220,7 +223,7 @@ func newHandler(
 			quit:      make(chan struct{}),
 		},
 
-		Instance: logger.New("PM"),
+		Instance: logger.New("sync"),
 	}
 	h.started.Add(1)
 
@@ -402,7 +405,7 @@ func newHandler(
 
 func (h *handler) peerMisbehaviour(peer string, err error) bool {
 	if eventcheck.IsBan(err) {
-		log.Warn("Dropping peer due to a misbehaviour", "peer", peer, "err", err)
+		h.Log.Warn("Dropping peer due to a misbehaviour", "peer", peer, "err", err)
 		h.removePeer(peer)
 		return true
 	}
@@ -465,6 +468,8 @@ func (h *handler) makeDagProcessor(checkers *eventcheck.Checkers) *dagprocessor.
 					return err
 				}
 
+				h.clockSkews.Observe(e.Creator(), e.CreationTime().Time(), preStart)
+
 				// event is connected, announce it
 				passedSinceEvent := preStart.Sub(e.CreationTime().Time())
 				h.BroadcastEvent(e, passedSinceEvent)
@@ -473,7 +478,7 @@ func (h *handler) makeDagProcessor(checkers *eventcheck.Checkers) *dagprocessor.
 			},
 			Released: func(e dag.Event, peer string, err error) {
 				if eventcheck.IsBan(err) {
-					log.Warn("Incoming event rejected", "event", e.ID().String(), "creator", e.Creator(), "err", err)
+					h.Log.Warn("Incoming event rejected", "event", e.ID().String(), "creator", e.Creator(), "err", err)
 					h.removePeer(peer)
 				}
 			},
@@ -517,7 +522,7 @@ func (h *handler) makeBvProcessor(checkers *eventcheck.Checkers) *bvprocessor.Pr
 			Process: h.process.BVs,
 			Released: func(bvs inter.LlrSignedBlockVotes, peer string, err error) {
 				if eventcheck.IsBan(err) {
-					log.Warn("Incoming BVs rejected", "BVs", bvs.Signed.Locator.ID(), "creator", bvs.Signed.Locator.Creator, "err", err)
+					h.Log.Warn("Incoming BVs rejected", "BVs", bvs.Signed.Locator.ID(), "creator", bvs.Signed.Locator.Creator, "err", err)
 					h.removePeer(peer)
 				}
 			},
@@ -534,7 +539,7 @@ func (h *handler) makeBrProcessor() *brprocessor.Processor {
 			Process: h.process.BR,
 			Released: func(br ibr.LlrIdxFullBlockRecord, peer string, err error) {
 				if eventcheck.IsBan(err) {
-					log.Warn("Incoming BR rejected", "block", br.Idx, "err", err)
+					h.Log.Warn("Incoming BR rejected", "block", br.Idx, "err", err)
 					h.removePeer(peer)
 				}
 			},
@@ -562,13 +567,13 @@ func (h *handler) makeEpProcessor(checkers *eventcheck.Checkers) *epprocessor.Pr
 			ProcessER: h.process.ER,
 			ReleasedEV: func(ev inter.LlrSignedEpochVote, peer string, err error) {
 				if eventcheck.IsBan(err) {
-					log.Warn("Incoming EV rejected", "event", ev.Signed.Locator.ID(), "creator", ev.Signed.Locator.Creator, "err", err)
+					h.Log.Warn("Incoming EV rejected", "event", ev.Signed.Locator.ID(), "creator", ev.Signed.Locator.Creator, "err", err)
 					h.removePeer(peer)
 				}
 			},
 			ReleasedER: func(er ier.LlrIdxFullEpochRecord, peer string, err error) {
 				if eventcheck.IsBan(err) {
-					log.Warn("Incoming ER rejected", "epoch", er.Idx, "err", err)
+					h.Log.Warn("Incoming ER rejected", "epoch", er.Idx, "err", err)
 					h.removePeer(peer)
 				}
 			},
@@ -615,7 +620,7 @@ func (h *handler) unregisterPeer(id string) {
 	if peer == nil {
 		return
 	}
-	log.Debug("Removing peer", "peer", id)
+	h.Log.Debug("Removing peer", "peer", id)
 
 	// Unregister the peer from the leecher's and seeder's and peer sets
 	_ = h.epLeecher.UnregisterPeer(id)
@@ -631,7 +636,7 @@ func (h *handler) unregisterPeer(id string) {
 		_ = h.snapLeecher.SnapSyncer.Unregister(id)
 	}
 	if err := h.peers.UnregisterPeer(id); err != nil {
-		log.Error("Peer removal failed", "peer", id, "err", err)
+		h.Log.Error("Peer removal failed", "peer", id, "err", err)
 	}
 }
 
@@ -689,7 +694,7 @@ func (h *handler) Start(maxPeers int) {
 }
 
 func (h *handler) Stop() {
-	log.Info("Stopping Fantom protocol")
+	h.Log.Info("Stopping Fantom protocol")
 
 	h.brLeecher.Stop()
 	h.brSeeder.Stop()
@@ -737,7 +742,7 @@ func (h *handler) Stop() {
 	h.wg.Wait()
 	h.peerWG.Wait()
 
-	log.Info("Fantom protocol stopped")
+	h.Log.Info("Fantom protocol stopped")
 }
 
 func (h *handler) myProgress() PeerProgress {
@@ -1336,7 +1341,7 @@ func (h *handler) BroadcastEvent(event *inter.EventPayload, passed time.Duration
 	id := event.ID()
 	peers := h.peers.PeersWithoutEvent(id)
 	if len(peers) == 0 {
-		log.Trace("Event is already known to all peers", "hash", id)
+		h.Log.Trace("Event is already known to all peers", "hash", id)
 		return 0
 	}
 
@@ -1352,7 +1357,7 @@ func (h *handler) BroadcastEvent(event *inter.EventPayload, passed time.Duration
 	for _, peer := range hashBroadcast {
 		peer.AsyncSendEventIDs(hash.Events{event.ID()}, peer.queue)
 	}
-	log.Trace("Broadcast event", "hash", id, "fullRecipients", len(fullBroadcast), "hashRecipients", len(hashBroadcast))
+	h.Log.Trace("Broadcast event", "hash", id, "fullRecipients", len(fullBroadcast), "hashRecipients", len(hashBroadcast))
 	return len(peers)
 }
 
@@ -1369,7 +1374,7 @@ func (h *handler) BroadcastTxs(txs types.Transactions) {
 			txset[peer] = append(txset[peer], tx)
 		}
 		totalSize += tx.Size()
-		log.Trace("Broadcast transaction", "hash", tx.Hash(), "recipients", len(peers))
+		h.Log.Trace("Broadcast transaction", "hash", tx.Hash(), "recipients", len(peers))
 	}
 	fullRecipients := h.decideBroadcastAggressiveness(int(totalSize), time.Second, len(txset))
 	i := 0
@@ -10,7 +10,7 @@ import (
 
 func NewLogger() *Logger {
 	return &Logger{
-		Instance: logger.New(),
+		Instance: logger.New("dag"),
 	}
 }
 
@@ -154,6 +154,8 @@ type Service struct {
 
 	tflusher PeriodicFlusher
 
+	txLatency *txLatencyMeter
+
 	logger.Instance
 }
 
@@ -223,6 +225,7 @@ func newService(config Config, store *Store, blockProc BlockProc, engine lachesi
 	// create tx pool
 	stateReader := svc.GetEvmStateReader()
 	svc.txpool = newTxPool(stateReader)
+	svc.txLatency = newTxLatencyMeter(svc.txpool, &svc.feed)
 
 	// init dialCandidates
 	dnsclient := dnsdisc.NewClient(dnsdisc.Config{})
@@ -411,6 +414,11 @@ func (s *Service) APIs() []rpc.API {
 			Version:   "1.0",
 			Service:   s.netRPCService,
 			Public:    true,
+		}, {
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   NewPrivateAdminAPI(s),
+			Public:    false,
 		},
 	}...)
 
@@ -422,6 +430,7 @@ func (s *Service) Start() error {
 	s.gpo.Start(&GPOBackend{s.store, s.txpool})
 	// start tflusher before starting snapshots generation
 	s.tflusher.Start()
+	s.txLatency.Start()
 	// start snapshots generation
 	if s.store.evm.IsEvmSnapshotPaused() && !s.config.AllowSnapsync {
 		return errors.New("cannot halt snapsync and start fullsync")
@@ -475,6 +484,7 @@ func (s *Service) Stop() error {
 	s.snapDialCandidates.Close()
 
 	s.handler.Stop()
+	s.txLatency.Stop()
 	s.feed.scope.Close()
 	s.eventMux.Stop()
 	s.gpo.Stop()
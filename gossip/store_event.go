@@ -6,15 +6,32 @@ package gossip
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
 
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/Fantom-foundation/go-opera/inter"
 )
 
+var (
+	// eventPayloadsCacheHitMeter counts event payloads served from the LRU cache
+	eventPayloadsCacheHitMeter = metrics.GetOrRegisterMeter("gossip/events/cache/payload/hit", nil)
+	// eventPayloadsCacheMissMeter counts event payloads which aren't in the LRU cache but found in DB.
+	// These are mostly evicted events (i.e. cache capacity issues), but also events which weren't read since the node restart.
+	eventPayloadsCacheMissMeter = metrics.GetOrRegisterMeter("gossip/events/cache/payload/miss", nil)
+	// eventHeadersCacheHitMeter counts event headers served from the LRU cache
+	eventHeadersCacheHitMeter = metrics.GetOrRegisterMeter("gossip/events/cache/header/hit", nil)
+	// eventHeadersCacheMissMeter counts event headers which aren't in the LRU cache but found in DB, with the same restart caveat
+	eventHeadersCacheMissMeter = metrics.GetOrRegisterMeter("gossip/events/cache/header/miss", nil)
+	// eventsMissingMeter counts requested events which aren't stored at all (i.e. sync issues)
+	eventsMissingMeter = metrics.GetOrRegisterMeter("gossip/events/missing", nil)
+)
+
 // DelEvent deletes event.
 func (s *Store) DelEvent(id hash.Event) {
 	key := id.Bytes()
@@ -45,11 +62,13 @@ func (s *Store) SetEvent(e *inter.EventPayload) {
 func (s *Store) GetEventPayload(id hash.Event) *inter.EventPayload {
 	// Get event from LRU cache first.
 	if ev, ok := s.cache.Events.Get(id); ok {
+		eventPayloadsCacheHitMeter.Mark(1)
 		return ev.(*inter.EventPayload)
 	}
 
 	key := id.Bytes()
 	w, _ := s.rlp.Get(s.table.Events, key, &inter.EventPayload{}).(*inter.EventPayload)
+	markEventCacheMiss(eventPayloadsCacheMissMeter, w != nil)
 
 	if w != nil {
 		fixEventTxHashes(w)
@@ -69,11 +88,13 @@ func (s *Store) GetEventPayload(id hash.Event) *inter.EventPayload {
 func (s *Store) GetEvent(id hash.Event) *inter.Event {
 	// Get event from LRU cache first.
 	if ev, ok := s.cache.EventsHeaders.Get(id); ok {
+		eventHeadersCacheHitMeter.Mark(1)
 		return ev.(*inter.Event)
 	}
 
 	key := id.Bytes()
 	w, _ := s.rlp.Get(s.table.Events, key, &inter.EventPayload{}).(*inter.EventPayload)
+	markEventCacheMiss(eventHeadersCacheMissMeter, w != nil)
 	if w == nil {
 		return nil
 	}
@@ -88,6 +109,16 @@ func (s *Store) GetEvent(id hash.Event) *inter.Event {
 	return &eh
 }
 
+// markEventCacheMiss distinguishes events which aren't in the cache (i.e. evicted or not read since the node restart)
+// from events which were never stored
+func markEventCacheMiss(cacheMiss metrics.Meter, stored bool) {
+	if stored {
+		cacheMiss.Mark(1)
+	} else {
+		eventsMissingMeter.Mark(1)
+	}
+}
+
 func (s *Store) forEachEvent(it ethdb.Iterator, onEvent func(event *inter.EventPayload) bool) {
 	for it.Next() {
 		event := &inter.EventPayload{}
@@ -139,6 +170,37 @@ func (s *Store) FindEventHashes(epoch idx.Epoch, lamport idx.Lamport, hashPrefix
 	return res
 }
 
+// FindEventHashesByHexPrefix returns event hashes which hex representation starts with the lower-case hexPrefix.
+// The prefix may have an odd number of hex digits. No more than limit IDs are returned.
+func (s *Store) FindEventHashesByHexPrefix(hexPrefix string, limit int) hash.Events {
+	prefix, err := hex.DecodeString(hexPrefix[:len(hexPrefix)/2*2])
+	if err != nil {
+		return hash.Events{}
+	}
+	var start []byte
+	if len(hexPrefix)%2 != 0 {
+		// start from the lowest key with the last digit as a high nibble
+		nibble, err := hex.DecodeString(hexPrefix[len(hexPrefix)-1:] + "0")
+		if err != nil {
+			return hash.Events{}
+		}
+		start = nibble
+	}
+	res := make(hash.Events, 0, limit)
+
+	it := s.table.Events.NewIterator(prefix, start)
+	defer it.Release()
+	for len(res) < limit && it.Next() {
+		// matching keys are contiguous
+		if !strings.HasPrefix(hex.EncodeToString(it.Key()), hexPrefix) {
+			break
+		}
+		res = append(res, hash.BytesToEvent(it.Key()))
+	}
+
+	return res
+}
+
 // GetEventPayloadRLP returns stored event. Serialized.
 func (s *Store) GetEventPayloadRLP(id hash.Event) rlp.RawValue {
 	key := id.Bytes()
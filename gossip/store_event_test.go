@@ -0,0 +1,130 @@
+package gossip
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/inter"
+	"github.com/Fantom-foundation/go-opera/logger"
+)
+
+func TestStore_FindEventHashesByWholeBytesPrefix(t *testing.T) {
+	logger.SetTestMode(t)
+	require := require.New(t)
+
+	store := NewMemStore()
+	defer store.Close()
+
+	ids := make(hash.Events, 0, 4)
+	for i := 0; i < 4; i++ {
+		me := &inter.MutableEventPayload{}
+		me.SetVersion(1)
+		me.SetEpoch(idx.Epoch(1 + i/2))
+		me.SetLamport(idx.Lamport(1 + i%2))
+		me.SetSeq(idx.Event(1 + i))
+		me.SetCreator(1)
+		e := me.Build()
+		store.SetEvent(e)
+		ids = append(ids, e.ID())
+	}
+
+	// full ID matches only one event
+	require.Equal(hash.Events{ids[2]}, store.FindEventHashesByHexPrefix(hex.EncodeToString(ids[2].Bytes()), 10))
+	// epoch prefix matches all the epoch events
+	require.ElementsMatch(ids[:2], store.FindEventHashesByHexPrefix(hex.EncodeToString(idx.Epoch(1).Bytes()), 10))
+	require.ElementsMatch(ids[2:], store.FindEventHashesByHexPrefix(hex.EncodeToString(idx.Epoch(2).Bytes()), 10))
+	// limit is respected
+	require.Len(store.FindEventHashesByHexPrefix("", 3), 3)
+	// unknown prefix
+	require.Empty(store.FindEventHashesByHexPrefix(hex.EncodeToString(idx.Epoch(3).Bytes()), 10))
+}
+
+func TestStore_FindEventHashesByHexPrefix(t *testing.T) {
+	logger.SetTestMode(t)
+	require := require.New(t)
+
+	store := NewMemStore()
+	defer store.Close()
+
+	ids := make(hash.Events, 0, 3)
+	for i := 0; i < 3; i++ {
+		me := &inter.MutableEventPayload{}
+		me.SetVersion(1)
+		me.SetEpoch(idx.Epoch(1))
+		me.SetLamport(idx.Lamport(1 + i*16))
+		me.SetCreator(1)
+		e := me.Build()
+		store.SetEvent(e)
+		ids = append(ids, e.ID())
+	}
+
+	// lamports 1, 17 and 33 differ in the 15th hex digit
+	require.ElementsMatch(ids, store.FindEventHashesByHexPrefix("00000001000000", 10))
+	require.Equal(hash.Events{ids[0]}, store.FindEventHashesByHexPrefix("000000010000000", 10))
+	require.Equal(hash.Events{ids[1]}, store.FindEventHashesByHexPrefix("000000010000001", 10))
+	require.Equal(hash.Events{ids[2]}, store.FindEventHashesByHexPrefix("000000010000002", 10))
+	require.Empty(store.FindEventHashesByHexPrefix("000000010000003", 10))
+	require.Empty(store.FindEventHashesByHexPrefix("1", 10))
+	// limit is respected
+	require.Len(store.FindEventHashesByHexPrefix("0", 2), 2)
+}
+
+func TestStore_EventCacheMeters(t *testing.T) {
+	logger.SetTestMode(t)
+	require := require.New(t)
+
+	// meters are no-op unless metrics are enabled, so replace them with working ones
+	meters := []*metrics.Meter{
+		&eventPayloadsCacheHitMeter, &eventPayloadsCacheMissMeter,
+		&eventHeadersCacheHitMeter, &eventHeadersCacheMissMeter,
+		&eventsMissingMeter,
+	}
+	for _, m := range meters {
+		prev := *m
+		*m = metrics.NewMeterForced()
+		defer func(m *metrics.Meter, prev, forced metrics.Meter) {
+			forced.Stop()
+			*m = prev
+		}(m, prev, *m)
+	}
+	counts := func() []int64 {
+		res := make([]int64, len(meters))
+		for i, m := range meters {
+			res[i] = (*m).Count()
+		}
+		return res
+	}
+
+	store := NewMemStore()
+	defer store.Close()
+
+	me := &inter.MutableEventPayload{}
+	me.SetVersion(1)
+	me.SetEpoch(1)
+	me.SetLamport(1)
+	e := me.Build()
+	store.SetEvent(e)
+
+	// hits
+	require.NotNil(store.GetEventPayload(e.ID()))
+	require.NotNil(store.GetEvent(e.ID()))
+	require.Equal([]int64{1, 0, 1, 0, 0}, counts())
+
+	// misses after the cache is reset, e.g. on restart
+	store.initCache()
+	require.NotNil(store.GetEventPayload(e.ID()))
+	store.initCache()
+	require.NotNil(store.GetEvent(e.ID()))
+	require.Equal([]int64{1, 1, 1, 1, 0}, counts())
+
+	// never stored events
+	unknown := hash.FakeEvent()
+	require.Nil(store.GetEventPayload(unknown))
+	require.Nil(store.GetEvent(unknown))
+	require.Equal([]int64{1, 1, 1, 1, 2}, counts())
+}
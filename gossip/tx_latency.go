@@ -0,0 +1,125 @@
+package gossip
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/Fantom-foundation/go-opera/evmcore"
+)
+
+const txLatencyBufferSize = 20000
+
+var (
+	// txEventLatencyTimer measures time since tx became pending in the txpool till its first inclusion into a connected event
+	txEventLatencyTimer = metrics.GetOrRegisterTimer("txs/latency/event", nil)
+	// txBlockLatencyTimer measures time since tx became pending in the txpool till its inclusion into a finalized block
+	txBlockLatencyTimer = metrics.GetOrRegisterTimer("txs/latency/block", nil)
+)
+
+type txLatencyEntry struct {
+	pending  time.Time
+	included bool
+}
+
+// txLatencyMeter tracks lifecycle of transactions promoted to pending in the local txpool.
+// Txs which are queued (e.g. because of a nonce gap) aren't tracked until they become pending.
+type txLatencyMeter struct {
+	pending *lru.Cache // tx hash -> *txLatencyEntry
+
+	eventTimer metrics.Timer
+	blockTimer metrics.Timer
+
+	txpool TxPool
+	feed   *ServiceFeed
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+func newTxLatencyMeter(txpool TxPool, feed *ServiceFeed) *txLatencyMeter {
+	pending, _ := lru.New(txLatencyBufferSize)
+	return &txLatencyMeter{
+		pending:    pending,
+		eventTimer: txEventLatencyTimer,
+		blockTimer: txBlockLatencyTimer,
+		txpool:     txpool,
+		feed:       feed,
+		quit:       make(chan struct{}),
+	}
+}
+
+// OnPending memorizes the time txs became pending
+func (m *txLatencyMeter) OnPending(txs types.Transactions, now time.Time) {
+	for _, tx := range txs {
+		m.pending.ContainsOrAdd(tx.Hash(), &txLatencyEntry{pending: now})
+	}
+}
+
+// OnIncluded measures latency of txs inclusion into a connected event.
+// Only first inclusion of a tx is taken into account.
+func (m *txLatencyMeter) OnIncluded(txs types.Transactions, now time.Time) {
+	for _, tx := range txs {
+		v, ok := m.pending.Peek(tx.Hash())
+		if !ok {
+			continue
+		}
+		entry := v.(*txLatencyEntry)
+		if entry.included {
+			continue
+		}
+		entry.included = true
+		m.eventTimer.Update(now.Sub(entry.pending))
+	}
+}
+
+// OnFinalized measures latency of txs inclusion into a finalized block
+func (m *txLatencyMeter) OnFinalized(txs types.Transactions, now time.Time) {
+	for _, tx := range txs {
+		v, ok := m.pending.Peek(tx.Hash())
+		if !ok {
+			continue
+		}
+		m.pending.Remove(tx.Hash())
+		m.blockTimer.Update(now.Sub(v.(*txLatencyEntry).pending))
+	}
+}
+
+func (m *txLatencyMeter) loop() {
+	defer m.wg.Done()
+
+	txsCh := make(chan evmcore.NewTxsNotify, txChanSize)
+	txsSub := m.txpool.SubscribeNewTxsNotify(txsCh)
+	defer txsSub.Unsubscribe()
+	blocksCh := make(chan evmcore.ChainHeadNotify, 16)
+	blocksSub := m.feed.SubscribeNewBlock(blocksCh)
+	defer blocksSub.Unsubscribe()
+
+	for {
+		select {
+		case notify := <-txsCh:
+			m.OnPending(notify.Txs, time.Now())
+		case notify := <-blocksCh:
+			m.OnFinalized(notify.Block.Transactions, time.Now())
+		case <-txsSub.Err():
+			return
+		case <-blocksSub.Err():
+			return
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+func (m *txLatencyMeter) Start() {
+	m.wg.Add(1)
+	go m.loop()
+}
+
+func (m *txLatencyMeter) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
@@ -0,0 +1,52 @@
+package gossip
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type testTimer struct {
+	metrics.NilTimer
+	values []time.Duration
+}
+
+func (t *testTimer) Update(d time.Duration) {
+	t.values = append(t.values, d)
+}
+
+func TestTxLatencyMeter(t *testing.T) {
+	require := require.New(t)
+
+	m := newTxLatencyMeter(nil, nil)
+	eventTimer, blockTimer := &testTimer{}, &testTimer{}
+	m.eventTimer, m.blockTimer = eventTimer, blockTimer
+
+	tx := func(nonce uint64) *types.Transaction {
+		return types.NewTransaction(nonce, [20]byte{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	}
+	tracked, untracked := tx(1), tx(2)
+	now := time.Unix(1000, 0)
+
+	m.OnPending(types.Transactions{tracked}, now)
+	// repeated notification doesn't reset the pending time
+	m.OnPending(types.Transactions{tracked}, now.Add(time.Second))
+
+	// only first inclusion into an event is measured
+	m.OnIncluded(types.Transactions{tracked, untracked}, now.Add(2*time.Second))
+	m.OnIncluded(types.Transactions{tracked}, now.Add(3*time.Second))
+	require.Equal([]time.Duration{2 * time.Second}, eventTimer.values)
+
+	m.OnFinalized(types.Transactions{tracked, untracked}, now.Add(5*time.Second))
+	require.Equal([]time.Duration{5 * time.Second}, blockTimer.values)
+
+	// finalized txs are no longer tracked
+	m.OnIncluded(types.Transactions{tracked}, now.Add(6*time.Second))
+	m.OnFinalized(types.Transactions{tracked}, now.Add(6*time.Second))
+	require.Len(eventTimer.values, 1)
+	require.Len(blockTimer.values, 1)
+}
@@ -116,6 +116,9 @@ func eventUnmarshalCSER(r *cser.Reader, e *MutableEventPayload) (err error) {
 	gasPowerLeft1 := r.U64()
 	// parents
 	parentsNum := r.U32()
+	if uint64(parentsNum)*24 > uint64(r.BytesR.Remaining()) {
+		return cser.ErrTooLargeAlloc
+	}
 	parents := make(hash.Events, 0, parentsNum)
 	for i := uint32(0); i < parentsNum; i++ {
 		// lamport difference
@@ -205,6 +208,9 @@ func (bvs *LlrBlockVotes) UnmarshalCSER(r *cser.Reader) error {
 	start := r.U64()
 	epoch := r.U32()
 	num := r.U32()
+	if uint64(num)*32 > uint64(r.BytesR.Remaining()) {
+		return cser.ErrTooLargeAlloc
+	}
 	records := make([]hash.Hash, num)
 	for i := range records {
 		r.FixedBytes(records[i][:])
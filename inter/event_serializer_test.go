@@ -15,6 +15,8 @@ import (
 
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+
+	"github.com/Fantom-foundation/go-opera/utils/cser"
 )
 
 func emptyEvent(ver uint8) EventPayload {
@@ -404,3 +406,47 @@ func FakeEvent(txsNum, mpsNum, bvsNum int, ersNum bool) *EventPayload {
 
 	return random.Build()
 }
+
+func TestLlrBlockVotesTooLargeAlloc(t *testing.T) {
+	require := require.New(t)
+
+	buf, err := cser.MarshalBinaryAdapter(func(w *cser.Writer) error {
+		w.U64(1)
+		w.U32(1)
+		w.U32(math.MaxUint32)
+		w.FixedBytes(make([]byte, 32))
+		return nil
+	})
+	require.NoError(err)
+
+	bvs := LlrBlockVotes{}
+	err = cser.UnmarshalBinaryAdapter(buf, bvs.UnmarshalCSER)
+	require.Equal(cser.ErrTooLargeAlloc, err)
+}
+
+func TestEventParentsTooLargeAlloc(t *testing.T) {
+	require := require.New(t)
+
+	buf, err := cser.MarshalBinaryAdapter(func(w *cser.Writer) error {
+		w.BitsW.Write(2, 0)
+		w.U8(1)
+		w.U16(0)
+		for i := 0; i < 5; i++ {
+			w.U32(1)
+		}
+		w.U64(1)
+		w.I64(0)
+		for i := 0; i < 3; i++ {
+			w.U64(1)
+		}
+		w.U32(math.MaxUint32)
+		w.U32(0)
+		w.FixedBytes(make([]byte, 24))
+		return nil
+	})
+	require.NoError(err)
+
+	e := MutableEventPayload{}
+	err = cser.UnmarshalBinaryAdapter(buf, e.UnmarshalCSER)
+	require.Equal(cser.ErrTooLargeAlloc, err)
+}
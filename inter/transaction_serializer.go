@@ -116,10 +116,17 @@ func TransactionUnmarshalCSER(r *cser.Reader) (*types.Transaction, error) {
 	} else if txType == types.AccessListTxType || txType == types.DynamicFeeTxType {
 		chainID := r.BigInt()
 		accessListLen := r.U32()
+		// every tuple takes at least 20 bytes of address
+		if uint64(accessListLen)*20 > uint64(r.BytesR.Remaining()) {
+			return nil, cser.ErrTooLargeAlloc
+		}
 		accessList := make(types.AccessList, accessListLen)
 		for i := range accessList {
 			r.FixedBytes(accessList[i].Address[:])
 			keysLen := r.U32()
+			if uint64(keysLen)*32 > uint64(r.BytesR.Remaining()) {
+				return nil, cser.ErrTooLargeAlloc
+			}
 			accessList[i].StorageKeys = make([]common.Hash, keysLen)
 			for j := range accessList[i].StorageKeys {
 				r.FixedBytes(accessList[i].StorageKeys[j][:])
@@ -0,0 +1,63 @@
+package inter
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/utils/cser"
+)
+
+func TestTransactionAccessListTooLargeAlloc(t *testing.T) {
+	// writes an access list tx up to the access list
+	writeTxHeader := func(w *cser.Writer) {
+		w.BitsW.Write(6, 0)
+		w.U8(types.AccessListTxType)
+		w.U64(1)
+		w.U64(21000)
+		w.BigInt(big.NewInt(1))
+		w.BigInt(big.NewInt(0))
+		w.Bool(false)
+		w.SliceBytes([]byte{})
+		w.BigInt(big.NewInt(0))
+		w.FixedBytes(make([]byte, 64))
+		w.BigInt(big.NewInt(1))
+	}
+
+	t.Run("tuples", func(t *testing.T) {
+		buf, err := cser.MarshalBinaryAdapter(func(w *cser.Writer) error {
+			writeTxHeader(w)
+			w.U32(math.MaxUint32)
+			w.FixedBytes(make([]byte, 20))
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = cser.UnmarshalBinaryAdapter(buf, func(r *cser.Reader) error {
+			_, err := TransactionUnmarshalCSER(r)
+			return err
+		})
+		require.Equal(t, cser.ErrTooLargeAlloc, err)
+	})
+
+	t.Run("storage keys", func(t *testing.T) {
+		buf, err := cser.MarshalBinaryAdapter(func(w *cser.Writer) error {
+			writeTxHeader(w)
+			w.U32(1)
+			w.FixedBytes(make([]byte, 20))
+			w.U32(math.MaxUint32)
+			w.FixedBytes(make([]byte, 32))
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = cser.UnmarshalBinaryAdapter(buf, func(r *cser.Reader) error {
+			_, err := TransactionUnmarshalCSER(r)
+			return err
+		})
+		require.Equal(t, cser.ErrTooLargeAlloc, err)
+	})
+}
@@ -1,9 +1,17 @@
 package logger
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/ethereum/go-ethereum/log"
 )
 
+var (
+	modulesMu sync.RWMutex
+	modules   = make(map[string]struct{})
+)
+
 type Instance struct {
 	Log log.Logger
 }
@@ -14,7 +22,30 @@ func New(name ...string) Instance {
 			Log: log.New(),
 		}
 	}
+	modulesMu.Lock()
+	modules[name[0]] = struct{}{}
+	modulesMu.Unlock()
 	return Instance{
 		Log: log.New("module", name[0]),
 	}
 }
+
+// Modules returns the sorted names of all the modules loggers were created for
+func Modules() []string {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+	res := make([]string, 0, len(modules))
+	for module := range modules {
+		res = append(res, module)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// IsModule returns true if a logger was created for the module
+func IsModule(name string) bool {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+	_, ok := modules[name]
+	return ok
+}
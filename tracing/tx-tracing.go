@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/opentracing/opentracing-go"
 )
 
@@ -59,6 +60,16 @@ func FinishTx(tx common.Hash, operation string) {
 	delete(txSpans, tx)
 }
 
+// FinishTxs finishes the lifecycle spans of txs, e.g. once they are processed in a block
+func FinishTxs(txs types.Transactions, operation string) {
+	if !enabled {
+		return
+	}
+	for _, tx := range txs {
+		FinishTx(tx.Hash(), operation)
+	}
+}
+
 func CheckTx(tx common.Hash, operation string) opentracing.Span {
 	if !enabled {
 		return noopSpan
@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinishTxs(t *testing.T) {
+	require := require.New(t)
+
+	tracer := mocktracer.New()
+	prevTracer := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	SetEnabled(true)
+	defer func() {
+		SetEnabled(false)
+		opentracing.SetGlobalTracer(prevTracer)
+	}()
+
+	traced := types.NewTransaction(1, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	untraced := types.NewTransaction(2, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	StartTx(traced.Hash(), "SendTx()")
+
+	FinishTxs(types.Transactions{traced, untraced}, "ProcessBlock()")
+	spans := tracer.FinishedSpans()
+	require.Len(spans, 1)
+	require.Equal(traced.Hash().String(), spans[0].Tag("txhash"))
+	require.Equal("SendTx()", spans[0].Tag("enter"))
+	require.Equal("ProcessBlock()", spans[0].Tag("exit"))
+
+	// finished spans are forgotten
+	FinishTxs(types.Transactions{traced}, "ProcessBlock()")
+	require.Len(tracer.FinishedSpans(), 1)
+}
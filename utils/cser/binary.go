@@ -47,7 +47,11 @@ func binaryToCSER(raw []byte) (bbits *bits.Array, bbytes []byte, err error) {
 func UnmarshalBinaryAdapter(raw []byte, unmarshalCser func(reader *Reader) error) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = ErrMalformedEncoding
+			if r == ErrTooLargeAlloc {
+				err = ErrTooLargeAlloc
+			} else {
+				err = ErrMalformedEncoding
+			}
 		}
 	}()
 
@@ -335,3 +335,20 @@ func randBytes(n int) []byte {
 	}
 	return bb
 }
+
+func TestTooLargeAlloc(t *testing.T) {
+	require := require.New(t)
+
+	buf, err := MarshalBinaryAdapter(func(w *Writer) error {
+		w.U56(1 << 48)
+		w.FixedBytes([]byte{1, 2, 3})
+		return nil
+	})
+	require.NoError(err)
+
+	err = UnmarshalBinaryAdapter(buf, func(r *Reader) error {
+		_ = r.SliceBytes()
+		return nil
+	})
+	require.Equal(ErrTooLargeAlloc, err)
+}
@@ -11,6 +11,7 @@ import (
 var (
 	ErrNonCanonicalEncoding = errors.New("non canonical encoding")
 	ErrMalformedEncoding    = errors.New("malformed encoding")
+	ErrTooLargeAlloc        = errors.New("too large allocation")
 )
 
 type Writer struct {
@@ -203,6 +204,9 @@ func (w *Writer) FixedBytes(v []byte) {
 func (r *Reader) SliceBytes() []byte {
 	// read slice size
 	size := r.U56()
+	if size > uint64(r.BytesR.Remaining()) {
+		panic(ErrTooLargeAlloc)
+	}
 	buf := make([]byte, size)
 	// read slice content
 	r.FixedBytes(buf)
@@ -0,0 +1,145 @@
+package dagstats
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// Header is the CSV header of an epoch record.
+// Distributions are space-separated "degree:count" pairs, ordered by degree.
+// children_referenced_avg counts only the events which are referenced at least once,
+// whereas the average over all the events is equal to parents_avg.
+// Rounds-to-decide isn't reported, because the frame an Atropos was decided at isn't stored.
+var Header = []string{
+	"epoch", "events", "validators", "frames", "roots", "roots_per_frame",
+	"parents_avg", "parents_max", "parents_dist",
+	"children_referenced_avg", "children_max", "children_dist",
+	"forks",
+}
+
+type creatorSeq struct {
+	creator idx.ValidatorID
+	seq     idx.Event
+}
+
+// Epoch accumulates DAG metrics of one epoch.
+// Events have to be added in topological order.
+type Epoch struct {
+	epoch       idx.Epoch
+	events      int
+	frames      idx.Frame
+	roots       int
+	parents     int
+	forks       int
+	parentsDist map[int]int
+	eventFrames map[hash.Event]idx.Frame
+	children    map[hash.Event]int
+	creators    map[idx.ValidatorID]struct{}
+	creatorSeqs map[creatorSeq]struct{}
+}
+
+func NewEpoch(epoch idx.Epoch) *Epoch {
+	return &Epoch{
+		epoch:       epoch,
+		parentsDist: make(map[int]int),
+		eventFrames: make(map[hash.Event]idx.Frame),
+		children:    make(map[hash.Event]int),
+		creators:    make(map[idx.ValidatorID]struct{}),
+		creatorSeqs: make(map[creatorSeq]struct{}),
+	}
+}
+
+// Epoch returns the epoch the metrics are accumulated for
+func (s *Epoch) Epoch() idx.Epoch {
+	return s.epoch
+}
+
+// Add accumulates metrics of an event
+func (s *Epoch) Add(e dag.Event) {
+	s.events++
+	s.eventFrames[e.ID()] = e.Frame()
+	if s.frames < e.Frame() {
+		s.frames = e.Frame()
+	}
+	// event is a root if it's the first event of a creator in the frame
+	if sp := e.SelfParent(); sp == nil || s.eventFrames[*sp] < e.Frame() {
+		s.roots++
+	}
+	s.parents += len(e.Parents())
+	s.parentsDist[len(e.Parents())]++
+	for _, p := range e.Parents() {
+		s.children[p]++
+	}
+	s.creators[e.Creator()] = struct{}{}
+	// every event with an already known creator and seq is a fork
+	seq := creatorSeq{e.Creator(), e.Seq()}
+	if _, ok := s.creatorSeqs[seq]; ok {
+		s.forks++
+	}
+	s.creatorSeqs[seq] = struct{}{}
+}
+
+// Record returns the metrics as a CSV record, see Header
+func (s *Epoch) Record() []string {
+	childrenDist := make(map[int]int)
+	for _, c := range s.children {
+		childrenDist[c]++
+	}
+	if unreferenced := s.events - len(s.children); unreferenced > 0 {
+		childrenDist[0] = unreferenced
+	}
+	return []string{
+		strconv.FormatUint(uint64(s.epoch), 10),
+		strconv.Itoa(s.events),
+		strconv.Itoa(len(s.creators)),
+		strconv.FormatUint(uint64(s.frames), 10),
+		strconv.Itoa(s.roots),
+		ratio(s.roots, int(s.frames)),
+		ratio(s.parents, s.events),
+		strconv.Itoa(maxDegree(s.parentsDist)),
+		formatDist(s.parentsDist),
+		ratio(s.parents, len(s.children)),
+		strconv.Itoa(maxDegree(childrenDist)),
+		formatDist(childrenDist),
+		strconv.Itoa(s.forks),
+	}
+}
+
+func ratio(a, b int) string {
+	if b == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(float64(a)/float64(b), 'f', 3, 64)
+}
+
+func degrees(dist map[int]int) []int {
+	res := make([]int, 0, len(dist))
+	for degree := range dist {
+		res = append(res, degree)
+	}
+	sort.Ints(res)
+	return res
+}
+
+func maxDegree(dist map[int]int) int {
+	res := 0
+	for degree := range dist {
+		if res < degree {
+			res = degree
+		}
+	}
+	return res
+}
+
+func formatDist(dist map[int]int) string {
+	pairs := make([]string, 0, len(dist))
+	for _, degree := range degrees(dist) {
+		pairs = append(pairs, strconv.Itoa(degree)+":"+strconv.Itoa(dist[degree]))
+	}
+	return strings.Join(pairs, " ")
+}
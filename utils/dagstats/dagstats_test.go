@@ -0,0 +1,60 @@
+package dagstats
+
+import (
+	"testing"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/go-opera/inter"
+)
+
+func fakeEvent(creator idx.ValidatorID, seq idx.Event, frame idx.Frame, lamport idx.Lamport, parents ...hash.Event) *inter.EventPayload {
+	me := &inter.MutableEventPayload{}
+	me.SetVersion(1)
+	me.SetEpoch(1)
+	me.SetCreator(creator)
+	me.SetSeq(seq)
+	me.SetFrame(frame)
+	me.SetLamport(lamport)
+	me.SetParents(parents)
+	return me.Build()
+}
+
+func TestEpoch(t *testing.T) {
+	require := require.New(t)
+
+	a1 := fakeEvent(1, 1, 1, 1)
+	b1 := fakeEvent(2, 1, 1, 1)
+	a2 := fakeEvent(1, 2, 1, 2, a1.ID(), b1.ID())
+	b2 := fakeEvent(2, 2, 2, 3, b1.ID(), a2.ID())
+	// fork of a2
+	a2f := fakeEvent(1, 2, 1, 2, a1.ID())
+
+	s := NewEpoch(1)
+	for _, e := range []*inter.EventPayload{a1, b1, a2, b2, a2f} {
+		s.Add(e)
+	}
+
+	require.Equal(len(Header), len(s.Record()))
+	require.Equal([]string{
+		"1",           // epoch
+		"5",           // events
+		"2",           // validators
+		"2",           // frames
+		"3",           // roots: a1, b1 and b2, which is the first event of its creator in frame 2
+		"1.500",       // roots_per_frame
+		"1.000",       // parents_avg
+		"2",           // parents_max
+		"0:2 1:1 2:2", // parents_dist
+		"1.667",       // children_referenced_avg: a1, b1 and a2 are referenced
+		"2",           // children_max
+		"0:2 1:1 2:2", // children_dist: b2 and a2f aren't referenced
+		"1",           // forks
+	}, s.Record())
+}
+
+func TestEpochEmpty(t *testing.T) {
+	require.Equal(t, []string{"7", "0", "0", "0", "0", "0", "0", "0", "", "0", "0", "", "0"}, NewEpoch(7).Record())
+}
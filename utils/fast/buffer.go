@@ -67,6 +67,11 @@ func (b *Writer) Bytes() []byte {
 	return b.buf
 }
 
+// Remaining returns the number of not consumed bytes
+func (b *Reader) Remaining() int {
+	return len(b.buf) - b.offset
+}
+
 // Empty returns true if the whole buffer is consumed
 func (b *Reader) Empty() bool {
 	return len(b.buf) == b.offset